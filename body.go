@@ -0,0 +1,92 @@
+package docx2pdf
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/DwifteJB/docx2pdf-bytes/docx2pdf/render"
+)
+
+// bodyElement is exactly one of Paragraph, Table or Drawing - whichever
+// docx body child it was parsed from. A Drawing here is one that appeared
+// directly under <w:body> rather than nested in a paragraph's run; real
+// documents put inline pictures inside a run instead (see
+// render.Run.Drawings), but a bare body>drawing is accepted too.
+type bodyElement struct {
+	Paragraph *render.Paragraph
+	Table     *render.Table
+	Drawing   *render.Drawing
+}
+
+// parseBody walks word/document.xml's <w:body> children with a streaming
+// xml.Decoder, rather than xml.Unmarshal into a struct, so paragraphs,
+// tables and drawings come back in document order instead of three
+// separately-collected slices. That in turn lets createPDF render inline
+// images where they actually sit relative to the surrounding text: each
+// render.Paragraph carries its own runs' drawings, in the order they
+// appeared, so createPDF can place them right after the paragraph's text.
+func parseBody(documentXML string) ([]bodyElement, error) {
+	dec := xml.NewDecoder(strings.NewReader(documentXML))
+
+	var elements []bodyElement
+	inBody := false
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		se, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		if !inBody {
+			if se.Name.Local == "body" {
+				inBody = true
+			}
+			continue
+		}
+
+		switch se.Name.Local {
+		case "p":
+			var para render.Paragraph
+			if err := dec.DecodeElement(&para, &se); err != nil {
+				return nil, err
+			}
+			elements = append(elements, bodyElement{Paragraph: &para})
+		case "tbl":
+			var table render.Table
+			if err := dec.DecodeElement(&table, &se); err != nil {
+				return nil, err
+			}
+			elements = append(elements, bodyElement{Table: &table})
+		case "drawing":
+			var drawing render.Drawing
+			if err := dec.DecodeElement(&drawing, &se); err != nil {
+				return nil, err
+			}
+			elements = append(elements, bodyElement{Drawing: &drawing})
+		default:
+			if err := dec.Skip(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return elements, nil
+}
+
+// emuPerInch is the number of EMUs (English Metric Units) per inch, as used
+// by wp:extent's cx/cy attributes.
+const emuPerInch = 914400
+
+// emuToPoints converts an EMU length (as found on wp:extent) to points.
+func emuToPoints(emu int64) float64 {
+	return float64(emu) / emuPerInch * 72
+}
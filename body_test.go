@@ -0,0 +1,52 @@
+package docx2pdf
+
+import "testing"
+
+func TestParseBodyInlineDrawingInRun(t *testing.T) {
+	const documentXML = `<w:document xmlns:w="w">
+<w:body>
+<w:p>
+<w:r><w:t>before</w:t></w:r>
+<w:r><w:drawing>
+<wp:inline xmlns:wp="wp">
+<wp:extent cx="914400" cy="457200"/>
+<a:graphic xmlns:a="a">
+<a:graphicData>
+<pic:pic xmlns:pic="pic">
+<pic:blipFill>
+<a:blip xmlns:r="r" r:embed="rId7"/>
+</pic:blipFill>
+</pic:pic>
+</a:graphicData>
+</a:graphic>
+</wp:inline>
+</w:drawing></w:r>
+<w:r><w:t>after</w:t></w:r>
+</w:p>
+</w:body>
+</w:document>`
+
+	elements, err := parseBody(documentXML)
+	if err != nil {
+		t.Fatalf("parseBody: %v", err)
+	}
+	if len(elements) != 1 || elements[0].Paragraph == nil {
+		t.Fatalf("elements = %+v, want a single paragraph", elements)
+	}
+
+	runs := elements[0].Paragraph.Runs
+	var drawings int
+	var embedID string
+	for _, run := range runs {
+		drawings += len(run.Drawings)
+		for _, d := range run.Drawings {
+			embedID = d.Image.ID
+		}
+	}
+	if drawings != 1 {
+		t.Fatalf("found %d drawings nested in runs, want 1", drawings)
+	}
+	if embedID != "rId7" {
+		t.Errorf("drawing embed ID = %q, want %q (pic:pic path must match on local name only)", embedID, "rId7")
+	}
+}
@@ -3,64 +3,11 @@ package docx2pdf
 import (
 	"archive/zip"
 	"bytes"
-	"encoding/xml"
-	"fmt"
 	"io"
-	"os"
-	"path/filepath"
-	"strconv"
-	"strings"
 
-	"github.com/jung-kurt/gofpdf"
+	"github.com/DwifteJB/docx2pdf-bytes/docx2pdf/render"
 )
 
-type Text struct {
-	Text string `xml:",chardata"`
-}
-
-type RunProperties struct {
-	Bold      bool   `xml:"b"`
-	Italic    bool   `xml:"i"`
-	FontSize  string `xml:"sz"`
-	FontColor string `xml:"color"`
-}
-
-type Run struct {
-	Properties RunProperties `xml:"rPr"`
-	Texts      []Text        `xml:"t"`
-}
-
-type Paragraph struct {
-	Alignment string `xml:"pPr>jc"` // Align: left, right, center
-	Runs      []Run  `xml:"r"`
-}
-
-type TableCell struct {
-	Text string `xml:"p>r>t"`
-}
-
-type TableRow struct {
-	Cells []TableCell `xml:"tc"`
-}
-
-type Table struct {
-	Rows []TableRow `xml:"tr"`
-}
-
-type Drawing struct {
-	Image ImageRef `xml:"inline>graphic>graphicData>pic:pic>blipFill>blip"`
-}
-
-type ImageRef struct {
-	ID string `xml:"embed,attr"`
-}
-
-type Document struct {
-	Paragraphs []Paragraph `xml:"body>p"`
-	Tables     []Table     `xml:"body>tbl"`
-	Drawings   []Drawing   `xml:"body>drawing"`
-}
-
 func extractTextFromDocx(docxBytes []byte) (string, error) {
 	reader, err := zip.NewReader(bytes.NewReader(docxBytes), int64(len(docxBytes)))
 	if err != nil {
@@ -88,160 +35,136 @@ func extractTextFromDocx(docxBytes []byte) (string, error) {
 	return documentXML, nil
 }
 
-func setFontFromRun(pdf *gofpdf.Fpdf, run Run) {
-	fontStyle := ""
-	if run.Properties.Bold {
-		fontStyle += "B"
-	}
-	if run.Properties.Italic {
-		fontStyle += "I"
+// imageSizeInPoints resolves a drawing's render size: wp:extent (EMUs) when
+// present, otherwise the image's intrinsic pixel size scaled by cfg.DPI,
+// otherwise the original fixed 50x50 placeholder.
+func imageSizeInPoints(d render.Drawing, imgBytes []byte, cfg render.Config) (w, h float64) {
+	if d.Extent.CX > 0 && d.Extent.CY > 0 {
+		return emuToPoints(d.Extent.CX), emuToPoints(d.Extent.CY)
 	}
-	fontSize := 12.0 // default font size
-	if run.Properties.FontSize != "" {
-		// convert font size from half-points to points
-		fontSizeValue, err := strconv.ParseFloat(run.Properties.FontSize, 64)
-		if err == nil {
-			fontSize = fontSizeValue / 2
-		}
+	if pxW, pxH, ok := decodeImagePixelSize(imgBytes); ok {
+		return render.PxToPt(float64(pxW), cfg.DPI), render.PxToPt(float64(pxH), cfg.DPI)
 	}
-	pdf.SetFont("Arial", fontStyle, fontSize)
-	if run.Properties.FontColor != "" {
-		pdf.SetTextColor(parseHexColor(run.Properties.FontColor))
-	}
-}
-
-func parseHexColor(s string) (int, int, int) {
-	var r, g, b int
-	fmt.Sscanf(s, "%02x%02x%02x", &r, &g, &b)
-	return r, g, b
+	return 50, 50
 }
 
-func setParagraphAlignment(_ *gofpdf.Fpdf, alignment string) string {
-	switch alignment {
-	case "center":
-		return "C"
-	case "right":
-		return "R"
-	default:
-		return "L"
+// placeDrawing resolves d's relationship ID to its media bytes and hands it
+// to the renderer. It is a no-op if the relationship or media entry is
+// missing rather than an error, since a docx can reference drawings
+// docx2pdf doesn't otherwise support.
+func placeDrawing(p render.Pdfer, d render.Drawing, rels map[string]string, images map[string][]byte, cfg render.Config) error {
+	mediaPath, exists := rels[d.Image.ID]
+	if !exists {
+		return nil
 	}
-}
-
-func processParagraph(pdf *gofpdf.Fpdf, para Paragraph) {
-	align := setParagraphAlignment(pdf, para.Alignment)
-	pdf.SetFont("Arial", "", 12)
-
-	for _, run := range para.Runs {
-		setFontFromRun(pdf, run)
-		for _, text := range run.Texts {
-			pdf.CellFormat(0, 6, text.Text, "", 1, align, false, 0, "")
-		}
+	imgBytes, exists := images[mediaPath]
+	if !exists {
+		return nil
 	}
 
-	pdf.Ln(4) // Spasi antar paragraf
-}
-
-func processTable(pdf *gofpdf.Fpdf, table Table) {
-	pdf.SetFont("Arial", "", 10)
-
-	for _, row := range table.Rows {
-		for _, cell := range row.Cells {
-			pdf.CellFormat(40, 10, cell.Text, "1", 0, "C", false, 0, "")
-		}
-		pdf.Ln(-1) // Pindah ke baris berikutnya
+	w, h := imageSizeInPoints(d, imgBytes, cfg)
+	opts := render.ImageOptions{
+		ImageType: detectImageType(mediaPath, imgBytes),
+		W:         w,
+		H:         h,
 	}
+	return p.AddImage(d.Image.ID, imgBytes, opts)
 }
 
-func addImageToPDF(pdf *gofpdf.Fpdf, imgPath string, x, y, width, height float64) {
-	pdf.Image(imgPath, x, y, width, height, false, "", 0, "")
-}
-
-func extractImagesFromDocx(_ []byte, reader *zip.Reader) (map[string]string, error) {
-	images := make(map[string]string)
-	tempDir, err := os.MkdirTemp("", "docx_images")
-	if err != nil {
+func createPDF(p render.Pdfer, elements []bodyElement, rels map[string]string, images map[string][]byte, cfg render.Config) ([]byte, error) {
+	if err := p.Setup(); err != nil {
 		return nil, err
 	}
 
-	for _, file := range reader.File {
-		if strings.HasPrefix(file.Name, "word/media/") {
-			rc, err := file.Open()
-			if err != nil {
+	for _, el := range elements {
+		switch {
+		case el.Paragraph != nil:
+			if err := p.AddParagraph(*el.Paragraph); err != nil {
 				return nil, err
 			}
-			defer rc.Close()
-
-			imgBytes, err := io.ReadAll(rc)
-			if err != nil {
+			// Inline pictures live inside a paragraph's runs
+			// (w:p>w:r>w:drawing), not as a direct child of w:body; place
+			// them right after the paragraph they occurred in.
+			for _, run := range el.Paragraph.Runs {
+				for _, drawing := range run.Drawings {
+					if err := placeDrawing(p, drawing, rels, images, cfg); err != nil {
+						return nil, err
+					}
+				}
+			}
+		case el.Table != nil:
+			if err := p.AddTable(*el.Table); err != nil {
 				return nil, err
 			}
-
-			imgPath := filepath.Join(tempDir, filepath.Base(file.Name))
-			err = os.WriteFile(imgPath, imgBytes, 0644)
-			if err != nil {
+		case el.Drawing != nil:
+			if err := placeDrawing(p, *el.Drawing, rels, images, cfg); err != nil {
 				return nil, err
 			}
-
-			images[file.Name] = imgPath
 		}
 	}
-	return images, nil
-}
-
-func createPDF(text string, images map[string]string) ([]byte, error) {
-	pdf := gofpdf.New("P", "mm", "A4", "")
-	pdf.AddPage()
 
-	var doc Document
-	err := xml.Unmarshal([]byte(text), &doc)
-	if err != nil {
+	var buf bytes.Buffer
+	if err := p.Save(&buf); err != nil {
 		return nil, err
 	}
+	return buf.Bytes(), nil
+}
 
-	for _, para := range doc.Paragraphs {
-		processParagraph(pdf, para)
-	}
-
-	for _, table := range doc.Tables {
-		processTable(pdf, table)
-	}
-
-	for _, drawing := range doc.Drawings {
-		imgPath, exists := images["word/media/"+drawing.Image.ID]
-		if exists {
-			addImageToPDF(pdf, imgPath, 10, 10, 50, 50) // Example coordinates and size
-		}
+func convert(inputBytes []byte, p render.Pdfer, cfg render.Config) ([]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(inputBytes), int64(len(inputBytes)))
+	if err != nil {
+		return nil, err
 	}
 
-	// create buffer to write pdf to
-	var buf bytes.Buffer
-	err = pdf.Output(&buf)
+	text, err := extractTextFromDocx(inputBytes)
 	if err != nil {
 		return nil, err
 	}
-	return buf.Bytes(), nil
-}
 
-func ConvertBytes(inputBytes []byte) ([]byte, error) {
-	reader, err := zip.NewReader(bytes.NewReader(inputBytes), int64(len(inputBytes)))
+	elements, err := parseBody(text)
 	if err != nil {
 		return nil, err
 	}
 
-	text, err := extractTextFromDocx(inputBytes)
+	rels, err := extractRelationships(reader)
 	if err != nil {
 		return nil, err
 	}
 
-	images, err := extractImagesFromDocx(inputBytes, reader)
+	images, err := extractImagesFromDocx(reader)
 	if err != nil {
 		return nil, err
 	}
 
-	pdfBytes, err := createPDF(text, images)
+	pdfBytes, err := createPDF(p, elements, rels, images, cfg)
 	if err != nil {
 		return nil, err
 	}
 
 	return pdfBytes, nil
 }
+
+// ConvertBytesWith converts docx bytes to PDF bytes using the supplied
+// Pdfer, so callers can plug in an alternate PDF backend (or a mock, for
+// testing) in place of the default gofpdf-backed renderer. If p also
+// implements render.DPIProvider (as GofpdfRenderer does), its own DPI is
+// used for pixel-to-point image scaling instead of DefaultConfig's, so a
+// custom-configured renderer's DPI isn't silently overridden.
+func ConvertBytesWith(inputBytes []byte, p render.Pdfer) ([]byte, error) {
+	cfg := render.DefaultConfig()
+	if dp, ok := p.(render.DPIProvider); ok {
+		cfg.DPI = dp.DPI()
+	}
+	return convert(inputBytes, p, cfg)
+}
+
+// ConvertBytesWithConfig converts docx bytes to PDF bytes, laying the page
+// out according to cfg (size, orientation, unit, margins, DPI) instead of
+// DefaultConfig.
+func ConvertBytesWithConfig(inputBytes []byte, cfg render.Config) ([]byte, error) {
+	return convert(inputBytes, render.NewGofpdfRendererWithConfig(cfg), cfg)
+}
+
+func ConvertBytes(inputBytes []byte) ([]byte, error) {
+	return ConvertBytesWith(inputBytes, render.NewGofpdfRenderer())
+}
@@ -0,0 +1,110 @@
+package docx2pdf
+
+import (
+	"archive/zip"
+	"bytes"
+	"image"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/DwifteJB/docx2pdf-bytes/docx2pdf/render"
+)
+
+// fakeDPIRenderer is a minimal render.Pdfer + render.DPIProvider that just
+// records the cfg.DPI convert() ends up calling AddImage with, so we can
+// assert ConvertBytesWith honors a custom renderer's own DPI rather than
+// always falling back to DefaultConfig's.
+type fakeDPIRenderer struct {
+	dpi               float64
+	gotImageDPIInputs []float64 // W passed to AddImage, for the caller to sanity-check scaling
+}
+
+func (r *fakeDPIRenderer) DPI() float64 { return r.dpi }
+
+func (r *fakeDPIRenderer) Setup() error                        { return nil }
+func (r *fakeDPIRenderer) AddParagraph(render.Paragraph) error { return nil }
+func (r *fakeDPIRenderer) AddTable(render.Table) error         { return nil }
+func (r *fakeDPIRenderer) Save(w io.Writer) error {
+	_, err := w.Write(nil)
+	return err
+}
+func (r *fakeDPIRenderer) AddImage(name string, data []byte, opts render.ImageOptions) error {
+	r.gotImageDPIInputs = append(r.gotImageDPIInputs, opts.W)
+	return nil
+}
+
+// buildDocxWithImage returns a docx referencing a media image with no
+// wp:extent, so its render size falls back to the pixel-size/DPI path in
+// imageSizeInPoints.
+func buildDocxWithImage(t *testing.T) []byte {
+	t.Helper()
+
+	const documentXML = `<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="w">
+<w:body>
+<w:p><w:r><w:drawing>
+<wp:inline xmlns:wp="wp">
+<a:graphic xmlns:a="a">
+<a:graphicData>
+<pic:pic xmlns:pic="pic">
+<pic:blipFill>
+<a:blip xmlns:r="r" r:embed="rId1"/>
+</pic:blipFill>
+</pic:pic>
+</a:graphicData>
+</a:graphic>
+</wp:inline>
+</w:drawing></w:r></w:p>
+</w:body>
+</w:document>`
+
+	const relsXML = `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="image" Target="media/image1.png"/>
+</Relationships>`
+
+	// A real 2x1 PNG, enough for image.DecodeConfig to report a size.
+	var pngBuf bytes.Buffer
+	if err := png.Encode(&pngBuf, image.NewRGBA(image.Rect(0, 0, 2, 1))); err != nil {
+		t.Fatalf("png.Encode: %v", err)
+	}
+	pngBytes := pngBuf.Bytes()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, data := range map[string][]byte{
+		"word/document.xml":            []byte(documentXML),
+		"word/_rels/document.xml.rels": []byte(relsXML),
+		"word/media/image1.png":        pngBytes,
+	} {
+		f, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("Create(%s): %v", name, err)
+		}
+		if _, err := f.Write(data); err != nil {
+			t.Fatalf("Write(%s): %v", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestConvertBytesWithUsesRendererDPI(t *testing.T) {
+	docxBytes := buildDocxWithImage(t)
+
+	r := &fakeDPIRenderer{dpi: 300}
+	if _, err := ConvertBytesWith(docxBytes, r); err != nil {
+		t.Fatalf("ConvertBytesWith error: %v", err)
+	}
+	if len(r.gotImageDPIInputs) != 1 {
+		t.Fatalf("AddImage called %d times, want 1", len(r.gotImageDPIInputs))
+	}
+
+	wantW := render.PxToPt(2, 300)
+	if got := r.gotImageDPIInputs[0]; got != wantW {
+		t.Errorf("image width = %v, want %v (scaled at renderer's DPI, not DefaultConfig's)", got, wantW)
+	}
+}
@@ -0,0 +1,72 @@
+package docx2pdf
+
+import (
+	"archive/zip"
+	"bytes"
+	_ "embed"
+	"testing"
+
+	"github.com/DwifteJB/docx2pdf-bytes/docx2pdf/render"
+)
+
+// dejaVuTestFont is a real TTF (reusing the renderer's own default face) so
+// AddUTF8FontFromBytes has valid glyph data to parse.
+//
+//go:embed docx2pdf/render/fonts/DejaVuSansCondensed.ttf
+var dejaVuTestFont []byte
+
+// buildMinimalDocx returns docx bytes containing just word/document.xml, with
+// a single run whose <w:rFonts w:ascii="..."> asks for fontName.
+func buildMinimalDocx(t *testing.T, fontName string) []byte {
+	t.Helper()
+
+	documentXML := `<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">
+<w:body>
+<w:p><w:r><w:rPr><w:rFonts w:ascii="` + fontName + `"/></w:rPr><w:t>Hello</w:t></w:r></w:p>
+</w:body>
+</w:document>`
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	f, err := zw.Create("word/document.xml")
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := f.Write([]byte(documentXML)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// TestConvertBytesWithRegisterFont exercises RegisterFont end-to-end through
+// ConvertBytesWith, called before Setup as the natural construction order
+// would have it, to guard against Setup clobbering the registered font on
+// the internal Setup call ConvertBytesWith itself triggers.
+func TestConvertBytesWithRegisterFont(t *testing.T) {
+	r := render.NewGofpdfRenderer()
+	r.RegisterFont("MyCustom", dejaVuTestFont)
+
+	docxBytes := buildMinimalDocx(t, "MyCustom")
+	if _, err := ConvertBytesWith(docxBytes, r); err != nil {
+		t.Fatalf("ConvertBytesWith error: %v", err)
+	}
+}
+
+// TestConvertBytesWithRegisterFontAfterSetup covers the other call order:
+// Setup called explicitly before RegisterFont.
+func TestConvertBytesWithRegisterFontAfterSetup(t *testing.T) {
+	r := render.NewGofpdfRenderer()
+	if err := r.Setup(); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	r.RegisterFont("MyCustom", dejaVuTestFont)
+
+	docxBytes := buildMinimalDocx(t, "MyCustom")
+	if _, err := ConvertBytesWith(docxBytes, r); err != nil {
+		t.Fatalf("ConvertBytesWith error: %v", err)
+	}
+}
@@ -0,0 +1,39 @@
+package render
+
+// Config controls page layout for a GofpdfRenderer: page size, orientation,
+// measurement unit, margins, and the DPI used to convert a docx drawing's
+// intrinsic pixel size into points when it carries no wp:extent.
+type Config struct {
+	PageSize    string // e.g. "A4", "Letter"
+	Orientation string // "P" (portrait) or "L" (landscape)
+	Unit        string // "mm", "pt", "in", "cm"
+
+	LeftMargin   float64
+	TopMargin    float64
+	RightMargin  float64
+	BottomMargin float64
+
+	DPI float64 // pixels per inch, for PxToPt
+}
+
+// DefaultConfig is the layout docx2pdf used before Config existed: A4
+// portrait in millimeters with 10-unit margins and a 96 DPI assumption for
+// pixel-sized images.
+func DefaultConfig() Config {
+	return Config{
+		PageSize:     "A4",
+		Orientation:  "P",
+		Unit:         "mm",
+		LeftMargin:   10,
+		TopMargin:    10,
+		RightMargin:  10,
+		BottomMargin: 10,
+		DPI:          96,
+	}
+}
+
+// PxToPt converts a pixel length to points at the given DPI (pixels per
+// inch).
+func PxToPt(px float64, dpi float64) float64 {
+	return px / dpi * 72
+}
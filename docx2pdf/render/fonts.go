@@ -0,0 +1,21 @@
+package render
+
+import _ "embed"
+
+//go:embed fonts/DejaVuSansCondensed.ttf
+var dejaVuRegular []byte
+
+//go:embed fonts/DejaVuSansCondensed-Bold.ttf
+var dejaVuBold []byte
+
+//go:embed fonts/DejaVuSansCondensed-Oblique.ttf
+var dejaVuItalic []byte
+
+//go:embed fonts/DejaVuSansCondensed-BoldOblique.ttf
+var dejaVuBoldItalic []byte
+
+// DefaultFontFamily is the UTF-8 capable family every GofpdfRenderer
+// registers on Setup, so CJK / Cyrillic / accented docx content survives
+// conversion instead of rendering as garbage (or vanishing) under gofpdf's
+// single-byte "Arial" default.
+const DefaultFontFamily = "DejaVuSansCondensed"
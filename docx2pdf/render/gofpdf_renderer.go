@@ -0,0 +1,165 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// GofpdfRenderer is the default Pdfer, backed by github.com/jung-kurt/gofpdf.
+type GofpdfRenderer struct {
+	pdf             *gofpdf.Fpdf
+	cfg             Config
+	registeredFonts map[string][]byte
+}
+
+// NewGofpdfRenderer returns a Pdfer that renders with DefaultConfig. Call
+// Setup before using it.
+func NewGofpdfRenderer() *GofpdfRenderer {
+	return NewGofpdfRendererWithConfig(DefaultConfig())
+}
+
+// NewGofpdfRendererWithConfig returns a Pdfer laid out according to cfg.
+// Call Setup before using it.
+func NewGofpdfRendererWithConfig(cfg Config) *GofpdfRenderer {
+	return &GofpdfRenderer{cfg: cfg}
+}
+
+// RegisterFont makes an additional UTF-8 TTF face available under name, so a
+// docx run whose <w:rFonts> asks for that face renders with its real glyphs
+// instead of falling back to DefaultFontFamily. Safe to call either before or
+// after Setup: the font is always stashed in registeredFonts, and Setup
+// (re-)applies every stashed font to the underlying gofpdf.Fpdf.
+func (g *GofpdfRenderer) RegisterFont(name string, ttf []byte) {
+	if g.registeredFonts == nil {
+		g.registeredFonts = make(map[string][]byte)
+	}
+	g.registeredFonts[name] = ttf
+	if g.pdf != nil {
+		g.pdf.AddUTF8FontFromBytes(name, "", ttf)
+	}
+}
+
+// Setup is idempotent: calling it more than once (e.g. once explicitly by a
+// caller, then again internally by ConvertBytesWith) re-uses the existing
+// gofpdf.Fpdf instead of discarding it, so fonts registered against the first
+// instance aren't silently dropped.
+func (g *GofpdfRenderer) Setup() error {
+	if g.pdf != nil {
+		return g.pdf.Error()
+	}
+
+	g.pdf = gofpdf.New(g.cfg.Orientation, g.cfg.Unit, g.cfg.PageSize, "")
+	g.pdf.SetMargins(g.cfg.LeftMargin, g.cfg.TopMargin, g.cfg.RightMargin)
+	g.pdf.SetAutoPageBreak(true, g.cfg.BottomMargin)
+	g.pdf.AddUTF8FontFromBytes(DefaultFontFamily, "", dejaVuRegular)
+	g.pdf.AddUTF8FontFromBytes(DefaultFontFamily, "B", dejaVuBold)
+	g.pdf.AddUTF8FontFromBytes(DefaultFontFamily, "I", dejaVuItalic)
+	g.pdf.AddUTF8FontFromBytes(DefaultFontFamily, "BI", dejaVuBoldItalic)
+	for name, ttf := range g.registeredFonts {
+		g.pdf.AddUTF8FontFromBytes(name, "", ttf)
+	}
+	g.pdf.AddPage()
+	return g.pdf.Error()
+}
+
+func (g *GofpdfRenderer) AddParagraph(p Paragraph) error {
+	align := alignmentCode(p.Alignment)
+	g.pdf.SetFont(DefaultFontFamily, "", 12)
+
+	for _, run := range p.Runs {
+		g.setFontFromRun(run)
+		for _, text := range run.Texts {
+			g.pdf.CellFormat(0, 6, text.Text, "", 1, align, false, 0, "")
+		}
+	}
+
+	g.pdf.Ln(4) // Spasi antar paragraf
+	return g.pdf.Error()
+}
+
+func (g *GofpdfRenderer) AddTable(t Table) error {
+	g.pdf.SetFont(DefaultFontFamily, "", 10)
+
+	for _, row := range t.Rows {
+		for _, cell := range row.Cells {
+			g.pdf.CellFormat(40, 10, cell.Text, "1", 0, "C", false, 0, "")
+		}
+		g.pdf.Ln(-1) // Pindah ke baris berikutnya
+	}
+	return g.pdf.Error()
+}
+
+// AddImage places the image at the renderer's current page-flow position
+// (the left margin, at whatever Y the preceding content ended on), so an
+// inline drawing lands where it actually occurred relative to the
+// surrounding text. The current Y is advanced afterward so later content
+// doesn't overlap it.
+func (g *GofpdfRenderer) AddImage(name string, data []byte, opts ImageOptions) error {
+	imageOpts := gofpdf.ImageOptions{ImageType: opts.ImageType, ReadDpi: true}
+	g.pdf.RegisterImageOptionsReader(name, imageOpts, bytes.NewReader(data))
+	g.pdf.ImageOptions(name, -1, g.pdf.GetY(), opts.W, opts.H, true, imageOpts, 0, "")
+	return g.pdf.Error()
+}
+
+func (g *GofpdfRenderer) Save(w io.Writer) error {
+	return g.pdf.Output(w)
+}
+
+// DPI implements DPIProvider, reporting the DPI g was actually configured
+// with instead of leaving callers to assume DefaultConfig's.
+func (g *GofpdfRenderer) DPI() float64 {
+	return g.cfg.DPI
+}
+
+func (g *GofpdfRenderer) setFontFromRun(run Run) {
+	family := DefaultFontFamily
+	if _, ok := g.registeredFonts[run.Properties.Fonts.Ascii]; ok {
+		family = run.Properties.Fonts.Ascii
+	}
+
+	fontStyle := ""
+	// Custom registered fonts only have a single "" face registered, so
+	// bold/italic only apply when we're still on DefaultFontFamily.
+	if family == DefaultFontFamily {
+		if run.Properties.Bold {
+			fontStyle += "B"
+		}
+		if run.Properties.Italic {
+			fontStyle += "I"
+		}
+	}
+
+	fontSize := 12.0 // default font size
+	if run.Properties.FontSize != "" {
+		// convert font size from half-points to points
+		fontSizeValue, err := strconv.ParseFloat(run.Properties.FontSize, 64)
+		if err == nil {
+			fontSize = fontSizeValue / 2
+		}
+	}
+	g.pdf.SetFont(family, fontStyle, fontSize)
+	if run.Properties.FontColor != "" {
+		g.pdf.SetTextColor(parseHexColor(run.Properties.FontColor))
+	}
+}
+
+func parseHexColor(s string) (int, int, int) {
+	var r, g, b int
+	fmt.Sscanf(s, "%02x%02x%02x", &r, &g, &b)
+	return r, g, b
+}
+
+func alignmentCode(alignment string) string {
+	switch alignment {
+	case "center":
+		return "C"
+	case "right":
+		return "R"
+	default:
+		return "L"
+	}
+}
@@ -0,0 +1,113 @@
+// Package render defines the document model docx2pdf feeds into a PDF
+// backend, and the Pdfer interface that backend must satisfy. Keeping this
+// separate from the docx parsing code lets callers swap in a different PDF
+// library (or a mock, for tests) without touching the docx side at all.
+package render
+
+import "io"
+
+// Text is a single run of chardata, as found inside a docx <w:t> element.
+type Text struct {
+	Text string `xml:",chardata"`
+}
+
+// RunFonts is a <w:rFonts>, naming the face a run asked Word to use.
+type RunFonts struct {
+	Ascii string `xml:"ascii,attr"`
+}
+
+// RunProperties holds the subset of <w:rPr> formatting docx2pdf understands.
+type RunProperties struct {
+	Bold      bool   `xml:"b"`
+	Italic    bool   `xml:"i"`
+	FontSize  string `xml:"sz"`
+	FontColor string `xml:"color"`
+	// Fonts is the face requested via <w:rFonts w:ascii="...">. It is only
+	// honored if a matching font was registered with RegisterFont;
+	// otherwise the renderer falls back to DefaultFontFamily.
+	//
+	// This can't be xml:"rFonts>ascii,attr" directly: encoding/xml rejects
+	// an attr flag on a ">"-chained nested-element path.
+	Fonts RunFonts `xml:"rFonts"`
+}
+
+// Run is a <w:r>: a span of text sharing the same RunProperties. A run can
+// also carry an inline drawing (<w:drawing>), e.g. an embedded image.
+type Run struct {
+	Properties RunProperties `xml:"rPr"`
+	Texts      []Text        `xml:"t"`
+	Drawings   []Drawing     `xml:"drawing"`
+}
+
+// Paragraph is a <w:p>.
+type Paragraph struct {
+	Alignment string `xml:"pPr>jc"` // left, right, center
+	Runs      []Run  `xml:"r"`
+}
+
+// TableCell is a <w:tc>.
+type TableCell struct {
+	Text string `xml:"p>r>t"`
+}
+
+// TableRow is a <w:tr>.
+type TableRow struct {
+	Cells []TableCell `xml:"tc"`
+}
+
+// Table is a <w:tbl>.
+type Table struct {
+	Rows []TableRow `xml:"tr"`
+}
+
+// Extent is a drawing's <wp:extent>, in EMUs (914400 per inch).
+type Extent struct {
+	CX int64 `xml:"cx,attr"`
+	CY int64 `xml:"cy,attr"`
+}
+
+// ImageRef is a <a:blip>, referencing embedded image data by relationship
+// ID rather than a media path directly.
+type ImageRef struct {
+	ID string `xml:"embed,attr"` // relationship ID, e.g. "rId7"
+}
+
+// Drawing is a <w:drawing>, wrapping an inline picture. Path segments here
+// match on local element name only (encoding/xml strips namespace
+// prefixes), so "pic" refers to <pic:pic>, not a literal "pic:pic" tag.
+type Drawing struct {
+	Image  ImageRef `xml:"inline>graphic>graphicData>pic>blipFill>blip"`
+	Extent Extent   `xml:"inline>extent"`
+}
+
+// ImageOptions describes how large an image should be placed. Positioning
+// isn't configurable here: a Pdfer places an image at its own current
+// page-flow position, so the image lands where it actually occurred relative
+// to the surrounding text.
+type ImageOptions struct {
+	ImageType string // e.g. "png", "jpg"; passed through to the backend
+	W, H      float64
+}
+
+// DPIProvider is an optional interface a Pdfer can implement to report the
+// DPI its own Config was built with. ConvertBytesWith type-asserts for it so
+// a custom-configured renderer's DPI (rather than DefaultConfig's) drives
+// pixel-to-point image scaling, without adding a DPI method to Pdfer itself.
+type DPIProvider interface {
+	DPI() float64
+}
+
+// Pdfer is the interface docx2pdf drives while rendering a converted
+// document. A default gofpdf-backed implementation is provided by
+// NewGofpdfRenderer, but callers can supply their own (unipdf, pdfcpu, a
+// mock for tests, ...) via ConvertBytesWith.
+type Pdfer interface {
+	// Setup prepares the document for rendering (creates the first page,
+	// applies any backend-level defaults).
+	Setup() error
+	AddParagraph(Paragraph) error
+	AddTable(Table) error
+	AddImage(name string, data []byte, opts ImageOptions) error
+	// Save writes the finished PDF to w.
+	Save(w io.Writer) error
+}
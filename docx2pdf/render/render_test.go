@@ -0,0 +1,21 @@
+package render
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestRunUnmarshalRFontsAscii(t *testing.T) {
+	const runXML = `<w:r xmlns:w="x"><w:rPr><w:rFonts w:ascii="Calibri"/></w:rPr><w:t>Hello World</w:t></w:r>`
+
+	var run Run
+	if err := xml.Unmarshal([]byte(runXML), &run); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got, want := run.Properties.Fonts.Ascii, "Calibri"; got != want {
+		t.Errorf("Fonts.Ascii = %q, want %q", got, want)
+	}
+	if len(run.Texts) != 1 || run.Texts[0].Text != "Hello World" {
+		t.Errorf("Texts = %+v, want [{Hello World}]", run.Texts)
+	}
+}
@@ -0,0 +1,80 @@
+package docx2pdf
+
+import (
+	"archive/zip"
+	"bytes"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// extractImagesFromDocx reads every part under word/media/ straight into
+// memory, keyed by its full zip path, so images never touch disk.
+func extractImagesFromDocx(reader *zip.Reader) (map[string][]byte, error) {
+	images := make(map[string][]byte)
+
+	for _, file := range reader.File {
+		if !strings.HasPrefix(file.Name, "word/media/") {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		imgBytes, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		images[file.Name] = imgBytes
+	}
+
+	return images, nil
+}
+
+// imageMagic maps the leading bytes of common embedded image formats to the
+// ImageType gofpdf expects.
+var imageMagic = []struct {
+	prefix []byte
+	typ    string
+}{
+	{[]byte("\x89PNG\r\n\x1a\n"), "png"},
+	{[]byte{0xFF, 0xD8, 0xFF}, "jpg"},
+	{[]byte("GIF87a"), "gif"},
+	{[]byte("GIF89a"), "gif"},
+	{[]byte("BM"), "bmp"},
+}
+
+// detectImageType identifies an embedded image's type from its magic bytes,
+// falling back to the word/media/ file extension when the bytes don't match
+// a known signature (docx is not guaranteed to name files accurately).
+func detectImageType(name string, data []byte) string {
+	for _, m := range imageMagic {
+		if bytes.HasPrefix(data, m.prefix) {
+			return m.typ
+		}
+	}
+
+	ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(name)), ".")
+	if ext == "jpeg" {
+		ext = "jpg"
+	}
+	return ext
+}
+
+// decodeImagePixelSize returns an embedded image's intrinsic width and
+// height in pixels, for drawings whose wp:extent is missing or zero.
+func decodeImagePixelSize(data []byte) (width, height int, ok bool) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, 0, false
+	}
+	return cfg.Width, cfg.Height, true
+}
@@ -0,0 +1,54 @@
+package docx2pdf
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"path"
+)
+
+type relationships struct {
+	Relationships []relationship `xml:"Relationship"`
+}
+
+type relationship struct {
+	ID     string `xml:"Id,attr"`
+	Target string `xml:"Target,attr"`
+}
+
+// extractRelationships parses word/_rels/document.xml.rels into a map from
+// relationship ID (e.g. "rId7") to the zip path it resolves to, so a
+// drawing's r:embed attribute - which is a relationship ID, not a media
+// path - can be turned into the word/media/ entry it actually points at.
+func extractRelationships(reader *zip.Reader) (map[string]string, error) {
+	rels := make(map[string]string)
+
+	for _, file := range reader.File {
+		if file.Name != "word/_rels/document.xml.rels" {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		var parsed relationships
+		if err := xml.Unmarshal(data, &parsed); err != nil {
+			return nil, err
+		}
+		for _, r := range parsed.Relationships {
+			// Targets are relative to word/, the directory document.xml.rels
+			// itself lives alongside.
+			rels[r.ID] = path.Join("word", r.Target)
+		}
+		break
+	}
+
+	return rels, nil
+}